@@ -17,13 +17,17 @@
 package ethdb
 
 import (
-	//"strconv"
-	//"strings"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"runtime"
 	"sync"
 	"time"
 
 	"github.com/dgraph-io/badger"
 	"github.com/dgraph-io/badger/options"
+	"github.com/dgraph-io/badger/pb"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/metrics"
@@ -42,9 +46,16 @@ type BadgerDatabase struct {
 	batchPutTimer   metrics.Timer
 	batchWriteTimer metrics.Timer
 	batchWriteMeter metrics.Meter
+	lsmSizeGauge    metrics.Gauge // Gauge for the on-disk size of the LSM tree
+	vlogSizeGauge   metrics.Gauge // Gauge for the on-disk size of the value log
 
 	quitLock sync.Mutex // Mutex protecting the quit channel access
 
+	// openSnapshots holds the read transactions backing outstanding
+	// Snapshot()s. Close forcibly discards any still open rather than
+	// blocking shutdown indefinitely on a caller that forgot to Release.
+	openSnapshots []*badger.Txn
+
 	log log.Logger // Contextual logger tracking the database path
 }
 
@@ -57,6 +68,12 @@ const (
 	cgInterval = 10 * time.Minute
 )
 
+func init() {
+	Register("badger", func(path string, cache, handles int) (Database, error) {
+		return NewBadgerDatabase(path)
+	})
+}
+
 // NewBadgerDatabase returns a BadgerDB wrapped object.
 func NewBadgerDatabase(file string) (*BadgerDatabase, error) {
 	logger := log.New("database", file)
@@ -80,6 +97,7 @@ func NewBadgerDatabase(file string) (*BadgerDatabase, error) {
 	}
 
 	go ret.runGC()
+	go ret.sampleSize()
 
 	return ret, nil
 }
@@ -93,6 +111,26 @@ func (db *BadgerDatabase) collectGarbage() error {
 	return db.db.RunValueLogGC(discardRatio)
 }
 
+// sampleSize periodically samples the on-disk LSM and value-log sizes into
+// the gauges registered by Meter, so operators can watch disk usage per
+// component on the same dashboards as the request metrics.
+// Should be run as a goroutine.
+func (db *BadgerDatabase) sampleSize() {
+	ticker := time.NewTicker(cgInterval)
+	for range ticker.C {
+		if db.lsmSizeGauge == nil && db.vlogSizeGauge == nil {
+			continue
+		}
+		lsm, vlog := db.db.Size()
+		if db.lsmSizeGauge != nil {
+			db.lsmSizeGauge.Update(lsm)
+		}
+		if db.vlogSizeGauge != nil {
+			db.vlogSizeGauge.Update(vlog)
+		}
+	}
+}
+
 // runGC triggers the garbage collection for the Badger backend db.
 // Should be run as a goroutine
 func (db *BadgerDatabase) runGC() {
@@ -178,6 +216,9 @@ func (db *BadgerDatabase) Get(key []byte) (dat []byte, err error) {
 		if db.missMeter != nil {
 			db.missMeter.Mark(1)
 		}
+		if err == badger.ErrKeyNotFound {
+			return nil, ErrNotFound
+		}
 		return nil, err
 	}
 
@@ -202,13 +243,18 @@ func (db *BadgerDatabase) Delete(key []byte) error {
 type badgerIterator struct {
 	txn            *badger.Txn
 	internIterator *badger.Iterator
+	start          []byte // key to seek to on the first Next, nil means start from the beginning
+	limit          []byte // exclusive upper bound, nil means unbounded
+	ownsTxn        bool   // whether Release should discard txn, false for iterators borrowing a Snapshot's txn
 	released       bool
 	initialised    bool
 }
 
 func (it *badgerIterator) Release() {
 	it.internIterator.Close()
-	it.txn.Discard()
+	if it.ownsTxn {
+		it.txn.Discard()
+	}
 	it.released = true
 }
 
@@ -216,14 +262,26 @@ func (it *badgerIterator) Released() bool {
 	return it.released
 }
 
+// Next advances the iterator, enforcing the half-open [start, limit) range
+// (if any) the iterator was created with, mirroring goleveldb's util.Range.
 func (it *badgerIterator) Next() bool {
 	if !it.initialised {
-		it.internIterator.Rewind()
+		if it.start != nil {
+			it.internIterator.Seek(it.start)
+		} else {
+			it.internIterator.Rewind()
+		}
 		it.initialised = true
 	} else {
 		it.internIterator.Next()
 	}
-	return it.internIterator.Valid()
+	if !it.internIterator.Valid() {
+		return false
+	}
+	if it.limit != nil && bytes.Compare(it.internIterator.Item().Key(), it.limit) >= 0 {
+		return false
+	}
+	return true
 }
 
 func (it *badgerIterator) Seek(key []byte) {
@@ -242,16 +300,196 @@ func (it *badgerIterator) Value() []byte {
 	return value
 }
 
-func (db *BadgerDatabase) NewIterator() badgerIterator {
-	txn := db.db.NewTransaction(false)
+// NewIterator returns an iterator over the entire keyspace.
+func (db *BadgerDatabase) NewIterator() Iterator {
+	return db.newIterator(badger.DefaultIteratorOptions, nil, nil)
+}
+
+// NewIteratorWithPrefix returns an iterator that only visits keys sharing the
+// given prefix. The prefix is pushed down to Badger via
+// badger.IteratorOptions.Prefix so whole SSTables outside of it can be
+// skipped, instead of filtering each key after a full-keyspace scan.
+func (db *BadgerDatabase) NewIteratorWithPrefix(prefix []byte) Iterator {
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+	return db.newIterator(opts, prefix, nil)
+}
+
+// NewKeyIteratorWithPrefix is the key-only counterpart of
+// NewIteratorWithPrefix: it disables value prefetching for callers that only
+// need to walk keys (e.g. existence checks, pruning).
+func (db *BadgerDatabase) NewKeyIteratorWithPrefix(prefix []byte) Iterator {
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+	opts.PrefetchValues = false
+	return db.newIterator(opts, prefix, nil)
+}
+
+// NewIteratorWithRange returns an iterator over the half-open key range
+// [start, limit), matching goleveldb's util.Range semantics. A nil limit
+// means unbounded.
+func (db *BadgerDatabase) NewIteratorWithRange(start, limit []byte) Iterator {
+	return db.newIterator(badger.DefaultIteratorOptions, start, limit)
+}
+
+// NewKeyIteratorWithRange is the key-only counterpart of
+// NewIteratorWithRange.
+func (db *BadgerDatabase) NewKeyIteratorWithRange(start, limit []byte) Iterator {
 	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	return db.newIterator(opts, start, limit)
+}
+
+func (db *BadgerDatabase) newIterator(opts badger.IteratorOptions, start, limit []byte) *badgerIterator {
+	txn := db.db.NewTransaction(false)
 	internIterator := txn.NewIterator(opts)
-	return badgerIterator{txn: txn, internIterator: internIterator, released: false, initialised: false}
+	return &badgerIterator{txn: txn, internIterator: internIterator, start: start, limit: limit, ownsTxn: true}
+}
+
+// Snapshot opens a long-lived, read-only view of the database pinned at the
+// current read timestamp, so a caller performing multiple Get/Iterator calls
+// (e.g. a state-at-block RPC handler or a snap-sync producer) sees a
+// consistent keyspace instead of each call opening its own read transaction.
+// This is the Badger analog of leveldb's DB.GetSnapshot.
+func (db *BadgerDatabase) Snapshot() (Snapshot, error) {
+	db.quitLock.Lock()
+	defer db.quitLock.Unlock()
+
+	txn := db.db.NewTransaction(false)
+	db.openSnapshots = append(db.openSnapshots, txn)
+	return &badgerSnapshot{db: db, txn: txn}, nil
+}
+
+// Snapshot is a consistent, point-in-time read view of a Database.
+type Snapshot interface {
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
+	NewIterator() Iterator
+	NewIteratorWithPrefix(prefix []byte) Iterator
+	NewIteratorWithRange(start, limit []byte) Iterator
+	Release()
+}
+
+type badgerSnapshot struct {
+	db  *BadgerDatabase
+	txn *badger.Txn
+}
+
+func (s *badgerSnapshot) Get(key []byte) ([]byte, error) {
+	item, err := s.txn.Get(key)
+	if err == badger.ErrKeyNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	val, err := item.Value()
+	if err != nil {
+		return nil, err
+	}
+	return snappy.Decode(nil, common.CopyBytes(val))
+}
+
+func (s *badgerSnapshot) Has(key []byte) (bool, error) {
+	_, err := s.txn.Get(key)
+	if err == badger.ErrKeyNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *badgerSnapshot) NewIterator() Iterator {
+	return s.newIterator(badger.DefaultIteratorOptions, nil, nil)
+}
+
+func (s *badgerSnapshot) NewIteratorWithPrefix(prefix []byte) Iterator {
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+	return s.newIterator(opts, prefix, nil)
+}
+
+func (s *badgerSnapshot) NewIteratorWithRange(start, limit []byte) Iterator {
+	return s.newIterator(badger.DefaultIteratorOptions, start, limit)
+}
+
+func (s *badgerSnapshot) newIterator(opts badger.IteratorOptions, start, limit []byte) *badgerIterator {
+	internIterator := s.txn.NewIterator(opts)
+	return &badgerIterator{txn: s.txn, internIterator: internIterator, start: start, limit: limit, ownsTxn: false}
+}
+
+// Release discards the underlying read transaction. It takes quitLock so it
+// can't race with Close force-discarding still-open snapshots.
+func (s *badgerSnapshot) Release() {
+	s.db.quitLock.Lock()
+	defer s.db.quitLock.Unlock()
+
+	for i, txn := range s.db.openSnapshots {
+		if txn == s.txn {
+			s.db.openSnapshots = append(s.db.openSnapshots[:i], s.db.openSnapshots[i+1:]...)
+			break
+		}
+	}
+	s.txn.Discard()
+}
+
+// Stat returns a human-readable rendering of a Badger engine statistic,
+// analogous to the leveldb-backed ethdb.Database's
+// DB.LDB().GetProperty("leveldb.iostats"). Supported properties:
+//
+//   - "" or "badger.levels": per-level SSTable summary, from DB.LevelsToString
+//   - "badger.size": on-disk LSM and value-log sizes in bytes, from DB.Size
+func (db *BadgerDatabase) Stat(property string) (string, error) {
+	switch property {
+	case "", "badger.levels":
+		return db.db.LevelsToString(), nil
+	case "badger.size":
+		lsm, vlog := db.db.Size()
+		return fmt.Sprintf("lsm=%d vlog=%d", lsm, vlog), nil
+	default:
+		return "", fmt.Errorf("ethdb: unknown badger property %q", property)
+	}
+}
+
+// Compact triggers a full compaction of the database: it flattens the LSM
+// tree down to a single level across GOMAXPROCS workers, then repeatedly
+// runs the value-log GC until RunValueLogGC reports nothing left to
+// rewrite. Badger has no range-scoped compaction primitive like leveldb's,
+// so start and limit are accepted only for signature symmetry with the
+// leveldb-backed ethdb.Database and are otherwise ignored - every call
+// compacts the whole keyspace, which is the expected use after a large
+// reorg or a pruning pass.
+func (db *BadgerDatabase) Compact(start, limit []byte) error {
+	if err := db.db.Flatten(runtime.GOMAXPROCS(0)); err != nil {
+		return err
+	}
+	for {
+		err := db.db.RunValueLogGC(discardRatio)
+		if err == badger.ErrNoRewrite {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
 }
 
 func (db *BadgerDatabase) Close() {
 	// hacky way around https://github.com/dgraph-io/badger/pull/437
 	time.Sleep(time.Second * 15)
+
+	// Force-discard any snapshot readers the caller never released, rather
+	// than blocking shutdown on them indefinitely. Only hold quitLock across
+	// this drain, not the sleep above: Snapshot and badgerSnapshot.Release
+	// also take it, and holding it for the full 15s would block a Release
+	// racing with Close for that long. Snapshot does not check whether the
+	// database has been closed.
+	db.quitLock.Lock()
+	for _, txn := range db.openSnapshots {
+		txn.Discard()
+	}
+	db.openSnapshots = nil
+	db.quitLock.Unlock()
+
 	err := db.db.Close()
 	if err == nil {
 		db.log.Info("Database closed")
@@ -260,6 +498,67 @@ func (db *BadgerDatabase) Close() {
 	}
 }
 
+// Backup streams every key with a version greater than since to w, in
+// Badger's own backup framing, returning the timestamp to resume from on a
+// later incremental call. Values are written exactly as stored on disk
+// (snappy-encoded by Put), so Backup itself does no extra encode/decode work.
+func (db *BadgerDatabase) Backup(w io.Writer, since uint64) (uint64, error) {
+	return db.db.Backup(w, since)
+}
+
+// Load restores a stream previously written by Backup. Because the stream
+// carries the same snappy-encoded bytes Put wrote to disk, Load must not
+// re-encode them - it is a straight replay into Badger.
+func (db *BadgerDatabase) Load(r io.Reader, maxBatchSize int) error {
+	return db.db.Load(r, maxBatchSize)
+}
+
+// StreamOptions configures StreamTo.
+type StreamOptions struct {
+	// NumGo is the number of goroutines Badger uses to scan its LSM tree in
+	// parallel. Zero uses Badger's own default.
+	NumGo int
+	// ChooseKey, if set, restricts the stream to keys for which it returns
+	// true, e.g. a chain segment (headers/bodies/receipts) or trie prefix.
+	ChooseKey func(key []byte) bool
+}
+
+// StreamTo exports the database (or the subset selected by opts.ChooseKey)
+// into dst using Badger's Stream framework, which reads the LSM tree
+// directly instead of paying for an Iterator+Put pass. Values on disk are
+// snappy-encoded by Put, so each value is decoded before being handed to
+// the Put of a dst.NewBatch() batch, which applies dst's own encoding; skipping
+// that decode would stack Put's encoding on top of the one already on disk.
+func (db *BadgerDatabase) StreamTo(dst Database, opts *StreamOptions) error {
+	stream := db.db.NewStream()
+	if opts != nil {
+		if opts.NumGo > 0 {
+			stream.NumGo = opts.NumGo
+		}
+		if opts.ChooseKey != nil {
+			chooseKey := opts.ChooseKey
+			stream.ChooseKey = func(item *badger.Item) bool {
+				return chooseKey(item.KeyCopy(nil))
+			}
+		}
+	}
+
+	batch := dst.NewBatch()
+	stream.Send = func(list *pb.KVList) error {
+		for _, kv := range list.Kv {
+			value, err := snappy.Decode(nil, kv.Value)
+			if err != nil {
+				return err
+			}
+			if err := batch.Put(kv.Key, value); err != nil {
+				return err
+			}
+		}
+		return batch.Write()
+	}
+	return stream.Orchestrate(context.Background())
+}
+
 // Meter configures the database metrics collectors and
 func (db *BadgerDatabase) Meter(prefix string) {
 	// Short circuit metering if the metrics system is disabled
@@ -276,16 +575,33 @@ func (db *BadgerDatabase) Meter(prefix string) {
 	db.batchPutTimer = metrics.NewRegisteredTimer(prefix+"user/batchPuts", nil)
 	db.batchWriteTimer = metrics.NewRegisteredTimer(prefix+"user/batchWriteTimes", nil)
 	db.batchWriteMeter = metrics.NewRegisteredMeter(prefix+"user/batchWrites", nil)
+	db.lsmSizeGauge = metrics.NewRegisteredGauge(prefix+"disk/lsm", nil)
+	db.vlogSizeGauge = metrics.NewRegisteredGauge(prefix+"disk/vlog", nil)
 }
 
 func (db *BadgerDatabase) NewBatch() Batch {
-	return &badgerBatch{db: db, b: make(map[string][]byte)}
+	return &badgerBatch{db: db, wb: db.db.NewWriteBatch()}
 }
 
+// batchEntry records a single Put in the order it was made, decoded back to
+// its original (pre-snappy) form, so Replay can hand it to higher layers
+// (e.g. to mirror writes to the ancient store) without re-decoding.
+type batchEntry struct {
+	key, value []byte
+}
+
+// badgerBatch is backed by badger.WriteBatch rather than a Go map: Put
+// forwards straight to wb.SetEntry instead of buffering into a
+// map[string][]byte, which preserves write order (the trie commit path
+// relies on ancestors being written before descendants), avoids the
+// per-Put string conversion and map growth, and lets Badger build SSTables
+// for the batch in parallel instead of inside a single db.Update txn that
+// could overflow Badger's per-transaction size limit on large sync batches.
 type badgerBatch struct {
-	db   *BadgerDatabase
-	b    map[string][]byte
-	size int
+	db      *BadgerDatabase
+	wb      *badger.WriteBatch
+	entries []batchEntry
+	size    int
 }
 
 func (b *badgerBatch) Put(key, value []byte) error {
@@ -293,9 +609,12 @@ func (b *badgerBatch) Put(key, value []byte) error {
 		defer b.db.batchPutTimer.UpdateSince(time.Now())
 	}
 
-	b.b[string(key)] = common.CopyBytes(value)
+	key = common.CopyBytes(key)
+	value = common.CopyBytes(value)
+	b.entries = append(b.entries, batchEntry{key: key, value: value})
 	b.size += len(value)
-	return nil
+
+	return b.wb.SetEntry(badger.NewEntry(key, snappy.Encode(nil, value)))
 }
 
 func (b *badgerBatch) Write() (err error) {
@@ -307,20 +626,33 @@ func (b *badgerBatch) Write() (err error) {
 		b.db.batchWriteMeter.Mark(int64(b.size))
 	}
 
-	err = b.db.db.Update(func(txn *badger.Txn) error {
-		for key, value := range b.b {
-			value = snappy.Encode(nil, value)
-			err = txn.Set([]byte(key), value)
-		}
-		return err
-	})
+	err = b.wb.Flush()
+
+	// A flushed WriteBatch can't be reused, so line up a fresh one for the
+	// next round of Puts, matching the reset-after-Write convention below.
+	b.wb = b.db.db.NewWriteBatch()
+	b.entries = nil
 	b.size = 0
-	b.b = make(map[string][]byte)
 	return err
 }
 
+// Replay replays the batch's Puts, in the order they were made, decoded back
+// to their original (pre-snappy) values. It mirrors leveldb's BatchReplay and
+// lets higher layers (e.g. mirroring writes to the ancient store or a remote
+// peer) consume the batch without re-serializing it.
+func (b *badgerBatch) Replay(w func(key, value []byte) error) error {
+	for _, e := range b.entries {
+		if err := w(e.key, e.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *badgerBatch) Discard() {
-	b.b = make(map[string][]byte)
+	b.wb.Cancel()
+	b.wb = b.db.db.NewWriteBatch()
+	b.entries = nil
 	b.size = 0
 }
 
@@ -329,13 +661,21 @@ func (b *badgerBatch) ValueSize() int {
 }
 
 func (b *badgerBatch) Reset() {
-	b.b = make(map[string][]byte)
+	b.wb.Cancel()
+	b.wb = b.db.db.NewWriteBatch()
+	b.entries = nil
 	b.size = 0
 }
 
 type table struct {
 	db     Database
 	prefix string
+
+	// snap, if set, pins the table to a single consistent Snapshot instead
+	// of letting each Get/Iterator call take its own read view. Used by
+	// callers (e.g. trie/state code) that need a coherent view of a
+	// prefixed keyspace across several reads.
+	snap Snapshot
 }
 
 // NewTable returns a Database object that prefixes all keys with a given
@@ -347,15 +687,32 @@ func NewTable(db Database, prefix string) Database {
 	}
 }
 
+// NewTableWithSnapshot returns a table-scoped Database like NewTable, but
+// backed by a pinned Snapshot so every Get/Iterator call on it observes the
+// same point-in-time view of the underlying keyspace.
+func NewTableWithSnapshot(db Database, prefix string, snap Snapshot) Database {
+	return &table{
+		db:     db,
+		prefix: prefix,
+		snap:   snap,
+	}
+}
+
 func (dt *table) Put(key []byte, value []byte) error {
 	return dt.db.Put(append([]byte(dt.prefix), key...), value)
 }
 
 func (dt *table) Has(key []byte) (bool, error) {
+	if dt.snap != nil {
+		return dt.snap.Has(append([]byte(dt.prefix), key...))
+	}
 	return dt.db.Has(append([]byte(dt.prefix), key...))
 }
 
 func (dt *table) Get(key []byte) ([]byte, error) {
+	if dt.snap != nil {
+		return dt.snap.Get(append([]byte(dt.prefix), key...))
+	}
 	return dt.db.Get(append([]byte(dt.prefix), key...))
 }
 
@@ -367,6 +724,76 @@ func (dt *table) Close() {
 	// Do nothing; don't close the underlying DB.
 }
 
+// prefixIterable is implemented by backends (BadgerDatabase, RocksDatabase)
+// that can push a key prefix down into their own iterator instead of
+// filtering a full-keyspace scan.
+type prefixIterable interface {
+	NewIteratorWithPrefix(prefix []byte) Iterator
+}
+
+// NewIterator returns an iterator scoped to this table's prefix. The prefix
+// is pushed down to the pinned Snapshot, if any, or else to the underlying
+// database when it implements prefixIterable; otherwise the whole keyspace
+// is scanned and keys outside the prefix are skipped.
+func (dt *table) NewIterator() Iterator {
+	prefix := []byte(dt.prefix)
+	if dt.snap != nil {
+		return &prefixIterator{it: dt.snap.NewIteratorWithPrefix(prefix), prefix: prefix}
+	}
+	if pi, ok := dt.db.(prefixIterable); ok {
+		return &prefixIterator{it: pi.NewIteratorWithPrefix(prefix), prefix: prefix}
+	}
+	// dt.db can't push the prefix down, so scan the whole keyspace and
+	// filter here instead of handing the caller every other table's data.
+	return &prefixIterator{it: dt.db.NewIterator(), prefix: prefix, filter: true}
+}
+
+// prefixIterator adapts an Iterator over stored (prefixed) keys into one
+// whose Key() returns the caller-facing, unprefixed key, mirroring how
+// tableBatch.Replay strips the same prefix back off. When filter is set the
+// underlying iterator was not scoped to prefix by the backend, so Next also
+// skips keys outside the prefix range instead of assuming every key the
+// backend yields already belongs to this table.
+type prefixIterator struct {
+	it     Iterator
+	prefix []byte
+	filter bool
+}
+
+func (p *prefixIterator) Release() {
+	p.it.Release()
+}
+
+func (p *prefixIterator) Released() bool {
+	return p.it.Released()
+}
+
+func (p *prefixIterator) Next() bool {
+	for p.it.Next() {
+		key := p.it.Key()
+		if bytes.HasPrefix(key, p.prefix) {
+			return true
+		}
+		if p.filter && bytes.Compare(key, p.prefix) < 0 {
+			continue
+		}
+		return false
+	}
+	return false
+}
+
+func (p *prefixIterator) Seek(key []byte) {
+	p.it.Seek(append(append([]byte{}, p.prefix...), key...))
+}
+
+func (p *prefixIterator) Key() []byte {
+	return bytes.TrimPrefix(p.it.Key(), p.prefix)
+}
+
+func (p *prefixIterator) Value() []byte {
+	return p.it.Value()
+}
+
 type tableBatch struct {
 	batch  Batch
 	prefix string
@@ -389,6 +816,14 @@ func (tb *tableBatch) Write() error {
 	return tb.batch.Write()
 }
 
+// Replay replays the batch's Puts with this table's prefix stripped back
+// off, so callers see the same keys they originally Put.
+func (tb *tableBatch) Replay(w func(key, value []byte) error) error {
+	return tb.batch.Replay(func(key, value []byte) error {
+		return w(bytes.TrimPrefix(key, []byte(tb.prefix)), value)
+	})
+}
+
 func (tb *tableBatch) ValueSize() int {
 	return tb.batch.ValueSize()
 }
@@ -0,0 +1,167 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package dbtest holds a backend-agnostic Database conformance suite, so
+// every ethdb backend (Badger, RocksDB, ...) can be run against the same
+// Put/Get/Delete/Batch/Iterator/Snapshot behavior instead of each backend
+// growing its own ad-hoc tests.
+package dbtest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// TestDatabaseSuite runs the common Database conformance tests against a
+// fresh instance returned by New for every subtest.
+func TestDatabaseSuite(t *testing.T, New func() ethdb.Database) {
+	t.Run("PutGetDelete", func(t *testing.T) { testPutGetDelete(t, New) })
+	t.Run("Batch", func(t *testing.T) { testBatch(t, New) })
+	t.Run("Iterator", func(t *testing.T) { testIterator(t, New) })
+	t.Run("Snapshot", func(t *testing.T) { testSnapshot(t, New) })
+}
+
+func testPutGetDelete(t *testing.T, New func() ethdb.Database) {
+	db := New()
+	defer db.Close()
+
+	key, value := []byte("foo"), []byte("bar")
+
+	if ok, err := db.Has(key); err != nil || ok {
+		t.Fatalf("Has before Put = (%v, %v), want (false, nil)", ok, err)
+	}
+	if err := db.Put(key, value); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if ok, err := db.Has(key); err != nil || !ok {
+		t.Fatalf("Has after Put = (%v, %v), want (true, nil)", ok, err)
+	}
+	got, err := db.Get(key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !bytes.Equal(got, value) {
+		t.Fatalf("Get = %x, want %x", got, value)
+	}
+	if err := db.Delete(key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if ok, err := db.Has(key); err != nil || ok {
+		t.Fatalf("Has after Delete = (%v, %v), want (false, nil)", ok, err)
+	}
+}
+
+func testBatch(t *testing.T, New func() ethdb.Database) {
+	db := New()
+	defer db.Close()
+
+	b := db.NewBatch()
+	var replayed [][2][]byte
+	entries := [][2][]byte{{[]byte("a"), []byte("1")}, {[]byte("b"), []byte("2")}, {[]byte("c"), []byte("3")}}
+	for _, e := range entries {
+		if err := b.Put(e[0], e[1]); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+	if err := b.Replay(func(key, value []byte) error {
+		replayed = append(replayed, [2][]byte{key, value})
+		return nil
+	}); err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	if len(replayed) != len(entries) {
+		t.Fatalf("Replay produced %d entries, want %d", len(replayed), len(entries))
+	}
+	for i, e := range entries {
+		if !bytes.Equal(replayed[i][0], e[0]) || !bytes.Equal(replayed[i][1], e[1]) {
+			t.Fatalf("Replay[%d] = %x/%x, want %x/%x", i, replayed[i][0], replayed[i][1], e[0], e[1])
+		}
+	}
+	if err := b.Write(); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	for _, e := range entries {
+		got, err := db.Get(e[0])
+		if err != nil {
+			t.Fatalf("Get(%x): %v", e[0], err)
+		}
+		if !bytes.Equal(got, e[1]) {
+			t.Fatalf("Get(%x) = %x, want %x", e[0], got, e[1])
+		}
+	}
+}
+
+func testIterator(t *testing.T, New func() ethdb.Database) {
+	db := New()
+	defer db.Close()
+
+	want := map[string]string{"key-1": "a", "key-2": "b", "other": "c"}
+	for k, v := range want {
+		if err := db.Put([]byte(k), []byte(v)); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+	it := db.NewIterator()
+	defer it.Release()
+
+	got := make(map[string]string)
+	for it.Next() {
+		got[string(it.Key())] = string(it.Value())
+	}
+	if len(got) != len(want) {
+		t.Fatalf("iterator visited %d keys, want %d", len(got), len(want))
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("iterator value for %q = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func testSnapshot(t *testing.T, New func() ethdb.Database) {
+	db := New()
+	defer db.Close()
+
+	key := []byte("key")
+	if err := db.Put(key, []byte("before")); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	snap, err := db.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot: %v", err)
+	}
+	defer snap.Release()
+
+	if err := db.Put(key, []byte("after")); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	got, err := snap.Get(key)
+	if err != nil {
+		t.Fatalf("snapshot Get: %v", err)
+	}
+	if !bytes.Equal(got, []byte("before")) {
+		t.Fatalf("snapshot Get = %q, want %q (snapshot should not see the later write)", got, "before")
+	}
+	got, err = db.Get(key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !bytes.Equal(got, []byte("after")) {
+		t.Fatalf("Get = %q, want %q", got, "after")
+	}
+}
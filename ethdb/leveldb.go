@@ -0,0 +1,297 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethdb
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+func init() {
+	Register("leveldb", func(path string, cache, handles int) (Database, error) {
+		return NewLDBDatabase(path, cache, handles)
+	})
+}
+
+// LDBDatabase is the original goleveldb-backed Database implementation,
+// registered as "leveldb" so Open can pick between it and the other
+// backends in this package.
+type LDBDatabase struct {
+	fn string // filename for reporting
+	db *leveldb.DB
+
+	getTimer      metrics.Timer
+	putTimer      metrics.Timer
+	delTimer      metrics.Timer
+	missMeter     metrics.Meter
+	readMeter     metrics.Meter
+	writeMeter    metrics.Meter
+	batchPutTimer metrics.Timer
+
+	log log.Logger
+}
+
+// NewLDBDatabase returns a LevelDB wrapped object. cache is the leveldb
+// cache size in MB and handles is the max number of open files.
+func NewLDBDatabase(file string, cache, handles int) (*LDBDatabase, error) {
+	logger := log.New("database", file)
+
+	if cache < 16 {
+		cache = 16
+	}
+	if handles < 16 {
+		handles = 16
+	}
+	db, err := leveldb.OpenFile(file, &opt.Options{
+		OpenFilesCacheCapacity: handles,
+		BlockCacheCapacity:     cache / 2 * opt.MiB,
+		WriteBuffer:            cache / 4 * opt.MiB,
+		Filter:                 filter.NewBloomFilter(10),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &LDBDatabase{fn: file, db: db, log: logger}, nil
+}
+
+// Path returns the path to the database directory.
+func (db *LDBDatabase) Path() string {
+	return db.fn
+}
+
+func (db *LDBDatabase) Put(key []byte, value []byte) error {
+	if db.putTimer != nil {
+		defer db.putTimer.UpdateSince(time.Now())
+	}
+	if db.writeMeter != nil {
+		db.writeMeter.Mark(int64(len(value)))
+	}
+	return db.db.Put(key, value, nil)
+}
+
+func (db *LDBDatabase) Has(key []byte) (bool, error) {
+	return db.db.Has(key, nil)
+}
+
+// Get returns the given key if it's present.
+func (db *LDBDatabase) Get(key []byte) ([]byte, error) {
+	if db.getTimer != nil {
+		defer db.getTimer.UpdateSince(time.Now())
+	}
+	dat, err := db.db.Get(key, nil)
+	if err != nil {
+		if err == leveldb.ErrNotFound {
+			if db.missMeter != nil {
+				db.missMeter.Mark(1)
+			}
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if db.readMeter != nil {
+		db.readMeter.Mark(int64(len(dat)))
+	}
+	return dat, nil
+}
+
+func (db *LDBDatabase) Delete(key []byte) error {
+	if db.delTimer != nil {
+		defer db.delTimer.UpdateSince(time.Now())
+	}
+	return db.db.Delete(key, nil)
+}
+
+func (db *LDBDatabase) Close() {
+	if err := db.db.Close(); err != nil {
+		db.log.Error("Failed to close database", "err", err)
+	} else {
+		db.log.Info("Database closed")
+	}
+}
+
+// Meter configures the database metrics collectors.
+func (db *LDBDatabase) Meter(prefix string) {
+	if !metrics.Enabled {
+		return
+	}
+	db.getTimer = metrics.NewRegisteredTimer(prefix+"user/gets", nil)
+	db.putTimer = metrics.NewRegisteredTimer(prefix+"user/puts", nil)
+	db.delTimer = metrics.NewRegisteredTimer(prefix+"user/dels", nil)
+	db.missMeter = metrics.NewRegisteredMeter(prefix+"user/misses", nil)
+	db.readMeter = metrics.NewRegisteredMeter(prefix+"user/reads", nil)
+	db.writeMeter = metrics.NewRegisteredMeter(prefix+"user/writes", nil)
+	db.batchPutTimer = metrics.NewRegisteredTimer(prefix+"user/batchPuts", nil)
+}
+
+// Stat backs ethdb.Database's Stat with goleveldb's own property interface,
+// e.g. Stat("leveldb.stats") or Stat("leveldb.iostats").
+func (db *LDBDatabase) Stat(property string) (string, error) {
+	return db.db.GetProperty(property)
+}
+
+// Compact triggers a leveldb range compaction; nil, nil compacts the entire
+// keyspace.
+func (db *LDBDatabase) Compact(start, limit []byte) error {
+	return db.db.CompactRange(util.Range{Start: start, Limit: limit})
+}
+
+type ldbIterator struct {
+	internIterator iterator.Iterator
+}
+
+func (it *ldbIterator) Release() {
+	it.internIterator.Release()
+}
+
+func (it *ldbIterator) Released() bool {
+	return !it.internIterator.Valid() && it.internIterator.Error() == nil
+}
+
+func (it *ldbIterator) Next() bool {
+	return it.internIterator.Next()
+}
+
+func (it *ldbIterator) Seek(key []byte) {
+	it.internIterator.Seek(key)
+}
+
+func (it *ldbIterator) Key() []byte {
+	return it.internIterator.Key()
+}
+
+func (it *ldbIterator) Value() []byte {
+	return it.internIterator.Value()
+}
+
+func (db *LDBDatabase) NewIterator() Iterator {
+	return &ldbIterator{db.db.NewIterator(nil, nil)}
+}
+
+func (db *LDBDatabase) NewIteratorWithPrefix(prefix []byte) Iterator {
+	return &ldbIterator{db.db.NewIterator(util.BytesPrefix(prefix), nil)}
+}
+
+func (db *LDBDatabase) NewIteratorWithRange(start, limit []byte) Iterator {
+	return &ldbIterator{db.db.NewIterator(&util.Range{Start: start, Limit: limit}, nil)}
+}
+
+// Snapshot pins a read-only view of the database, mirroring
+// BadgerDatabase.Snapshot. This is the original inspiration for that API:
+// goleveldb's DB.GetSnapshot.
+func (db *LDBDatabase) Snapshot() (Snapshot, error) {
+	snap, err := db.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &ldbSnapshot{snap: snap}, nil
+}
+
+type ldbSnapshot struct {
+	snap *leveldb.Snapshot
+}
+
+func (s *ldbSnapshot) Get(key []byte) ([]byte, error) {
+	dat, err := s.snap.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	return dat, err
+}
+
+func (s *ldbSnapshot) Has(key []byte) (bool, error) {
+	return s.snap.Has(key, nil)
+}
+
+func (s *ldbSnapshot) NewIterator() Iterator {
+	return &ldbIterator{s.snap.NewIterator(nil, nil)}
+}
+
+func (s *ldbSnapshot) NewIteratorWithPrefix(prefix []byte) Iterator {
+	return &ldbIterator{s.snap.NewIterator(util.BytesPrefix(prefix), nil)}
+}
+
+func (s *ldbSnapshot) NewIteratorWithRange(start, limit []byte) Iterator {
+	return &ldbIterator{s.snap.NewIterator(&util.Range{Start: start, Limit: limit}, nil)}
+}
+
+func (s *ldbSnapshot) Release() {
+	s.snap.Release()
+}
+
+type ldbBatch struct {
+	db      *LDBDatabase
+	b       *leveldb.Batch
+	entries []batchEntry
+	size    int
+}
+
+func (db *LDBDatabase) NewBatch() Batch {
+	return &ldbBatch{db: db, b: new(leveldb.Batch)}
+}
+
+func (b *ldbBatch) Put(key, value []byte) error {
+	if b.db.batchPutTimer != nil {
+		defer b.db.batchPutTimer.UpdateSince(time.Now())
+	}
+	key = common.CopyBytes(key)
+	value = common.CopyBytes(value)
+	b.entries = append(b.entries, batchEntry{key: key, value: value})
+	b.size += len(value)
+	b.b.Put(key, value)
+	return nil
+}
+
+func (b *ldbBatch) Write() error {
+	err := b.db.db.Write(b.b, nil)
+	b.b.Reset()
+	b.entries = nil
+	b.size = 0
+	return err
+}
+
+func (b *ldbBatch) Replay(w func(key, value []byte) error) error {
+	for _, e := range b.entries {
+		if err := w(e.key, e.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *ldbBatch) Discard() {
+	b.b.Reset()
+	b.entries = nil
+	b.size = 0
+}
+
+func (b *ldbBatch) ValueSize() int {
+	return b.size
+}
+
+func (b *ldbBatch) Reset() {
+	b.b.Reset()
+	b.entries = nil
+	b.size = 0
+}
@@ -0,0 +1,81 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethdb
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrNotFound is returned by Get/Snapshot.Get when the key doesn't exist,
+// independent of which backend is in use.
+var ErrNotFound = errors.New("ethdb: not found")
+
+// Iterator walks a range of key/value pairs in key order. It is the
+// backend-agnostic counterpart of badgerIterator/rocksIterator, so table and
+// the dbtest suite can operate on any registered Database.
+type Iterator interface {
+	Release()
+	Released() bool
+	Next() bool
+	Seek(key []byte)
+	Key() []byte
+	Value() []byte
+}
+
+// Opener constructs a Database backend rooted at path, sized by cache (MB)
+// and handles (max open file descriptors). Backends register one of these
+// under a name ("badger", "leveldb", "rocksdb") so a caller can select an
+// engine by passing that name to Open without needing to know which package
+// implements it.
+type Opener func(path string, cache, handles int) (Database, error)
+
+var openers = make(map[string]Opener)
+
+// Register makes a backend available under name for Open. No command-line
+// flag reads the registry; the engine name reaches it only through Open's
+// name argument. It is meant to be called from a backend's init
+// function, and panics on a duplicate name since that indicates two
+// backend packages were imported under the same engine name.
+func Register(name string, opener Opener) {
+	if _, exists := openers[name]; exists {
+		panic(fmt.Sprintf("ethdb: backend %q already registered", name))
+	}
+	openers[name] = opener
+}
+
+// Engines returns the names of all registered backends, sorted, for use in
+// flag help text and error messages.
+func Engines() []string {
+	names := make([]string, 0, len(openers))
+	for name := range openers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Open constructs the backend registered under name, rooted at path and
+// sized by cache (MB) and handles (max open file descriptors).
+func Open(name, path string, cache, handles int) (Database, error) {
+	opener, ok := openers[name]
+	if !ok {
+		return nil, fmt.Errorf("ethdb: unknown backend %q, have %v", name, Engines())
+	}
+	return opener(path, cache, handles)
+}
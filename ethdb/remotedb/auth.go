@@ -0,0 +1,73 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package remotedb
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const bearerMetadataKey = "authorization"
+
+// bearerToken implements the server-side auth interceptors. The client sends
+// the same token through perRPCToken, under the same bearerMetadataKey.
+type bearerToken string
+
+func (t bearerToken) authUnary(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := t.check(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (t bearerToken) authStream(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := t.check(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (t bearerToken) check(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "remotedb: missing metadata")
+	}
+	values := md.Get(bearerMetadataKey)
+	if len(values) != 1 || values[0] != "Bearer "+string(t) {
+		return status.Error(codes.Unauthenticated, "remotedb: invalid or missing bearer token")
+	}
+	return nil
+}
+
+// perRPCToken implements credentials.PerRPCCredentials so the client attaches
+// the bearer token to every call.
+type perRPCToken struct {
+	token      string
+	requireTLS bool
+}
+
+func (c perRPCToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{bearerMetadataKey: "Bearer " + c.token}, nil
+}
+
+func (c perRPCToken) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
@@ -0,0 +1,275 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package remotedb
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/remotedb/remotedbpb"
+	"github.com/ethereum/go-ethereum/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ClientConfig configures Dial's transport and authentication.
+type ClientConfig struct {
+	UseTLS                bool
+	TLSInsecureSkipVerify bool // accept self-signed certs; for development only
+	BearerToken           string
+}
+
+// Client dials a remotedb Server and implements ethdb.Database itself, so
+// core/rawdb consumers reading the chain DB through it can't tell the
+// difference from a local backend.
+type Client struct {
+	conn *grpc.ClientConn
+	rpc  remotedbpb.EthDBClient
+	log  log.Logger
+}
+
+// Dial connects to a remotedb Server listening at addr.
+func Dial(addr string, cfg ClientConfig) (*Client, error) {
+	var creds credentials.TransportCredentials
+	if cfg.UseTLS {
+		creds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: cfg.TLSInsecureSkipVerify})
+	} else {
+		creds = insecure.NewCredentials()
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if cfg.BearerToken != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(perRPCToken{token: cfg.BearerToken, requireTLS: cfg.UseTLS}))
+	}
+
+	conn, err := grpc.Dial(addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, rpc: remotedbpb.NewEthDBClient(conn), log: log.New("remotedb", addr)}, nil
+}
+
+func (c *Client) Put(key, value []byte) error {
+	_, err := c.rpc.Put(context.Background(), &remotedbpb.PutRequest{Key: key, Value: value})
+	return err
+}
+
+func (c *Client) Has(key []byte) (bool, error) {
+	resp, err := c.rpc.Has(context.Background(), &remotedbpb.HasRequest{Key: key})
+	if err != nil {
+		return false, err
+	}
+	return resp.Has, nil
+}
+
+func (c *Client) Get(key []byte) ([]byte, error) {
+	resp, err := c.rpc.Get(context.Background(), &remotedbpb.GetRequest{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Found {
+		return nil, ethdb.ErrNotFound
+	}
+	return resp.Value, nil
+}
+
+func (c *Client) Delete(key []byte) error {
+	_, err := c.rpc.Delete(context.Background(), &remotedbpb.DeleteRequest{Key: key})
+	return err
+}
+
+// Close tears down the gRPC connection. It does not close the remote
+// database - the server owns that lifecycle.
+func (c *Client) Close() {
+	c.conn.Close()
+}
+
+func (c *Client) NewBatch() ethdb.Batch {
+	return &clientBatch{client: c}
+}
+
+func (c *Client) NewIterator() ethdb.Iterator {
+	return c.newIterator(&remotedbpb.IterateRequest{})
+}
+
+func (c *Client) NewIteratorWithPrefix(prefix []byte) ethdb.Iterator {
+	return c.newIterator(&remotedbpb.IterateRequest{Prefix: prefix})
+}
+
+func (c *Client) NewIteratorWithRange(start, limit []byte) ethdb.Iterator {
+	return c.newIterator(&remotedbpb.IterateRequest{Start: start, Limit: limit})
+}
+
+func (c *Client) newIterator(req *remotedbpb.IterateRequest) ethdb.Iterator {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := c.rpc.Iterate(ctx, req)
+	if err != nil {
+		cancel()
+		return &clientIterator{err: err, done: true}
+	}
+	return &clientIterator{stream: stream, cancel: cancel}
+}
+
+// Snapshot opens a Snapshot on the server and scopes subsequent Get/Has/
+// Iterator calls through it, mirroring BadgerDatabase.Snapshot across the
+// wire.
+func (c *Client) Snapshot() (ethdb.Snapshot, error) {
+	resp, err := c.rpc.Snapshot(context.Background(), &remotedbpb.SnapshotRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &clientSnapshot{client: c, id: resp.SnapshotId}, nil
+}
+
+type clientSnapshot struct {
+	client *Client
+	id     string
+}
+
+func (s *clientSnapshot) Get(key []byte) ([]byte, error) {
+	resp, err := s.client.rpc.Get(context.Background(), &remotedbpb.GetRequest{Key: key, SnapshotId: s.id})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Found {
+		return nil, ethdb.ErrNotFound
+	}
+	return resp.Value, nil
+}
+
+func (s *clientSnapshot) Has(key []byte) (bool, error) {
+	resp, err := s.client.rpc.Has(context.Background(), &remotedbpb.HasRequest{Key: key, SnapshotId: s.id})
+	if err != nil {
+		return false, err
+	}
+	return resp.Has, nil
+}
+
+func (s *clientSnapshot) NewIterator() ethdb.Iterator {
+	return s.client.newIterator(&remotedbpb.IterateRequest{SnapshotId: s.id})
+}
+
+func (s *clientSnapshot) NewIteratorWithPrefix(prefix []byte) ethdb.Iterator {
+	return s.client.newIterator(&remotedbpb.IterateRequest{Prefix: prefix, SnapshotId: s.id})
+}
+
+func (s *clientSnapshot) NewIteratorWithRange(start, limit []byte) ethdb.Iterator {
+	return s.client.newIterator(&remotedbpb.IterateRequest{Start: start, Limit: limit, SnapshotId: s.id})
+}
+
+func (s *clientSnapshot) Release() {
+	s.client.rpc.ReleaseSnapshot(context.Background(), &remotedbpb.ReleaseSnapshotRequest{SnapshotId: s.id})
+}
+
+// clientIterator adapts the server-streamed Iterate RPC to ethdb.Iterator.
+// Seek is unsupported once the stream is open: the range was already fixed
+// server-side by the request that started it.
+type clientIterator struct {
+	stream remotedbpb.EthDB_IterateClient
+	cancel context.CancelFunc
+	cur    *remotedbpb.IterateResponse
+	err    error
+	done   bool
+}
+
+func (it *clientIterator) Next() bool {
+	if it.done {
+		return false
+	}
+	resp, err := it.stream.Recv()
+	if err != nil {
+		it.done = true
+		if err != io.EOF {
+			it.err = err
+		}
+		return false
+	}
+	it.cur = resp
+	return true
+}
+
+func (it *clientIterator) Seek(key []byte) {}
+
+func (it *clientIterator) Key() []byte {
+	return it.cur.Key
+}
+
+func (it *clientIterator) Value() []byte {
+	return it.cur.Value
+}
+
+func (it *clientIterator) Release() {
+	if it.cancel != nil {
+		it.cancel()
+	}
+	it.done = true
+}
+
+func (it *clientIterator) Released() bool {
+	return it.done
+}
+
+// clientBatch buffers Puts client-side and ships them as one BatchWrite RPC
+// on Write, mirroring badgerBatch's write-ordering and Replay contract.
+type clientBatch struct {
+	client  *Client
+	ops     []*remotedbpb.BatchOp
+	entries []batchEntry
+	size    int
+}
+
+type batchEntry struct {
+	key, value []byte
+}
+
+func (b *clientBatch) Put(key, value []byte) error {
+	key = append([]byte(nil), key...)
+	value = append([]byte(nil), value...)
+	b.entries = append(b.entries, batchEntry{key: key, value: value})
+	b.size += len(value)
+	b.ops = append(b.ops, &remotedbpb.BatchOp{Op: &remotedbpb.BatchOp_Put{Put: &remotedbpb.PutRequest{Key: key, Value: value}}})
+	return nil
+}
+
+func (b *clientBatch) Write() error {
+	_, err := b.client.rpc.BatchWrite(context.Background(), &remotedbpb.BatchWriteRequest{Ops: b.ops})
+	b.ops, b.entries, b.size = nil, nil, 0
+	return err
+}
+
+func (b *clientBatch) Replay(w func(key, value []byte) error) error {
+	for _, e := range b.entries {
+		if err := w(e.key, e.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *clientBatch) Discard() {
+	b.ops, b.entries, b.size = nil, nil, 0
+}
+
+func (b *clientBatch) ValueSize() int {
+	return b.size
+}
+
+func (b *clientBatch) Reset() {
+	b.ops, b.entries, b.size = nil, nil, 0
+}
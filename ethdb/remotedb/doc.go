@@ -0,0 +1,29 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package remotedb exposes an ethdb.Database over gRPC, modeled after
+// cometbft/tm-db's remotedb. Server wraps any ethdb.Database (including a
+// BadgerDatabase) and serves it; Client dials a Server and implements
+// ethdb.Database itself, so core/rawdb consumers can't tell the difference
+// from a local backend.
+//
+// This lets out-of-process tooling - an explorer/indexer, `geth db
+// inspect`, or EL/CL separation tooling - read the chain database while
+// geth is running, by going through geth's in-process reader rather than
+// opening the data directory a second time, which Badger forbids.
+package remotedb
+
+//go:generate protoc -I . --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative remotedb.proto
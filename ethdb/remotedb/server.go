@@ -0,0 +1,303 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package remotedb
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/remotedb/remotedbpb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// ServerConfig configures optional transport security and authentication for
+// a Server. A zero-value ServerConfig serves in the clear with no auth,
+// which is only appropriate on a loopback or otherwise trusted listener.
+type ServerConfig struct {
+	TLSCert     string // path to a PEM certificate; empty disables TLS
+	TLSKey      string // path to the matching PEM private key
+	BearerToken string // if set, every RPC must carry this as a Bearer token
+}
+
+// Server adapts an ethdb.Database to the EthDB gRPC service, so any backend
+// (Badger included) can be read by out-of-process tooling without opening
+// the data directory a second time.
+type Server struct {
+	remotedbpb.UnimplementedEthDBServer
+
+	db ethdb.Database
+
+	mu             sync.Mutex
+	snapshots      map[string]ethdb.Snapshot
+	nextSnapshotID uint64
+
+	getTimer   metrics.Timer
+	putTimer   metrics.Timer
+	delTimer   metrics.Timer
+	iterMeter  metrics.Meter
+	batchMeter metrics.Meter
+}
+
+// NewServer wraps db for serving over gRPC.
+func NewServer(db ethdb.Database) *Server {
+	return &Server{db: db, snapshots: make(map[string]ethdb.Snapshot)}
+}
+
+// Meter registers per-method metrics under prefix, mirroring
+// BadgerDatabase.Meter so remote calls show up in the same dashboards as
+// local ones.
+func (s *Server) Meter(prefix string) {
+	if !metrics.Enabled {
+		return
+	}
+	s.getTimer = metrics.NewRegisteredTimer(prefix+"remote/gets", nil)
+	s.putTimer = metrics.NewRegisteredTimer(prefix+"remote/puts", nil)
+	s.delTimer = metrics.NewRegisteredTimer(prefix+"remote/dels", nil)
+	s.iterMeter = metrics.NewRegisteredMeter(prefix+"remote/iterates", nil)
+	s.batchMeter = metrics.NewRegisteredMeter(prefix+"remote/batchWrites", nil)
+}
+
+// Serve starts a gRPC server for s on addr and blocks until the listener is
+// closed or ctx is done.
+func Serve(ctx context.Context, addr string, s *Server, cfg ServerConfig) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("remotedb: listen on %s: %w", addr, err)
+	}
+
+	var opts []grpc.ServerOption
+	if cfg.TLSCert != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCert, cfg.TLSKey)
+		if err != nil {
+			return fmt.Errorf("remotedb: load TLS keypair: %w", err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}})))
+	}
+	if cfg.BearerToken != "" {
+		token := bearerToken(cfg.BearerToken)
+		opts = append(opts, grpc.ChainUnaryInterceptor(token.authUnary), grpc.ChainStreamInterceptor(token.authStream))
+	}
+
+	srv := grpc.NewServer(opts...)
+	remotedbpb.RegisterEthDBServer(srv, s)
+
+	go func() {
+		<-ctx.Done()
+		srv.GracefulStop()
+	}()
+	log.Info("remotedb server listening", "addr", addr)
+	return srv.Serve(lis)
+}
+
+func (s *Server) Get(ctx context.Context, req *remotedbpb.GetRequest) (*remotedbpb.GetResponse, error) {
+	if s.getTimer != nil {
+		defer s.getTimer.UpdateSince(time.Now())
+	}
+	reader, err := s.reader(req.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+	value, err := reader.Get(req.Key)
+	if err == ethdb.ErrNotFound {
+		return &remotedbpb.GetResponse{Found: false}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &remotedbpb.GetResponse{Value: value, Found: true}, nil
+}
+
+func (s *Server) Has(ctx context.Context, req *remotedbpb.HasRequest) (*remotedbpb.HasResponse, error) {
+	reader, err := s.reader(req.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+	has, err := reader.Has(req.Key)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &remotedbpb.HasResponse{Has: has}, nil
+}
+
+func (s *Server) Put(ctx context.Context, req *remotedbpb.PutRequest) (*remotedbpb.PutResponse, error) {
+	if s.putTimer != nil {
+		defer s.putTimer.UpdateSince(time.Now())
+	}
+	if err := s.db.Put(req.Key, req.Value); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &remotedbpb.PutResponse{}, nil
+}
+
+func (s *Server) Delete(ctx context.Context, req *remotedbpb.DeleteRequest) (*remotedbpb.DeleteResponse, error) {
+	if s.delTimer != nil {
+		defer s.delTimer.UpdateSince(time.Now())
+	}
+	if err := s.db.Delete(req.Key); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &remotedbpb.DeleteResponse{}, nil
+}
+
+func (s *Server) BatchWrite(ctx context.Context, req *remotedbpb.BatchWriteRequest) (*remotedbpb.BatchWriteResponse, error) {
+	if s.batchMeter != nil {
+		s.batchMeter.Mark(int64(len(req.Ops)))
+	}
+	// Batch has no Delete of its own (mirroring badgerBatch), so a delete
+	// can't simply be appended to the batch alongside the puts. Instead
+	// flush whatever puts are pending before applying each delete straight
+	// to the database, which preserves the op order the request arrived
+	// in (a put followed by a delete of the same key ends up deleted).
+	batch := s.db.NewBatch()
+	var pending bool
+	for _, op := range req.Ops {
+		switch o := op.Op.(type) {
+		case *remotedbpb.BatchOp_Put:
+			if err := batch.Put(o.Put.Key, o.Put.Value); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			pending = true
+		case *remotedbpb.BatchOp_Delete:
+			if pending {
+				if err := batch.Write(); err != nil {
+					return nil, status.Error(codes.Internal, err.Error())
+				}
+				batch.Reset()
+				pending = false
+			}
+			if err := s.db.Delete(o.Delete.Key); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		default:
+			return nil, status.Error(codes.InvalidArgument, "remotedb: empty batch op")
+		}
+	}
+	if pending {
+		if err := batch.Write(); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &remotedbpb.BatchWriteResponse{}, nil
+}
+
+func (s *Server) Iterate(req *remotedbpb.IterateRequest, stream remotedbpb.EthDB_IterateServer) error {
+	if s.iterMeter != nil {
+		s.iterMeter.Mark(1)
+	}
+	reader, err := s.reader(req.SnapshotId)
+	if err != nil {
+		return err
+	}
+
+	var it ethdb.Iterator
+	switch {
+	case len(req.Prefix) > 0:
+		it = reader.NewIteratorWithPrefix(req.Prefix)
+	case len(req.Start) > 0 || len(req.Limit) > 0:
+		it = reader.NewIteratorWithRange(req.Start, req.Limit)
+	default:
+		it = reader.NewIterator()
+	}
+	defer it.Release()
+
+	for it.Next() {
+		if err := stream.Send(&remotedbpb.IterateResponse{Key: it.Key(), Value: it.Value()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) Snapshot(ctx context.Context, req *remotedbpb.SnapshotRequest) (*remotedbpb.SnapshotResponse, error) {
+	snap, err := s.db.Snapshot()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	id := strconv.FormatUint(atomic.AddUint64(&s.nextSnapshotID, 1), 10)
+	s.mu.Lock()
+	s.snapshots[id] = snap
+	s.mu.Unlock()
+
+	return &remotedbpb.SnapshotResponse{SnapshotId: id}, nil
+}
+
+func (s *Server) ReleaseSnapshot(ctx context.Context, req *remotedbpb.ReleaseSnapshotRequest) (*remotedbpb.ReleaseSnapshotResponse, error) {
+	s.mu.Lock()
+	snap, ok := s.snapshots[req.SnapshotId]
+	delete(s.snapshots, req.SnapshotId)
+	s.mu.Unlock()
+
+	if ok {
+		snap.Release()
+	}
+	return &remotedbpb.ReleaseSnapshotResponse{}, nil
+}
+
+// statter is implemented by backends that expose engine-level statistics
+// (e.g. BadgerDatabase.Stat). Backends without it simply don't support the
+// Stats RPC.
+type statter interface {
+	Stat(property string) (string, error)
+}
+
+func (s *Server) Stats(ctx context.Context, req *remotedbpb.StatsRequest) (*remotedbpb.StatsResponse, error) {
+	st, ok := s.db.(statter)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "remotedb: backend does not support Stat")
+	}
+	value, err := st.Stat(req.Property)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &remotedbpb.StatsResponse{Value: value}, nil
+}
+
+// reader is shared by Get/Has/Iterate to scope a request to a Snapshot when
+// one was given, or to the live database otherwise.
+type reader interface {
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
+	NewIterator() ethdb.Iterator
+	NewIteratorWithPrefix(prefix []byte) ethdb.Iterator
+	NewIteratorWithRange(start, limit []byte) ethdb.Iterator
+}
+
+func (s *Server) reader(snapshotID string) (reader, error) {
+	if snapshotID == "" {
+		return s.db, nil
+	}
+	s.mu.Lock()
+	snap, ok := s.snapshots[snapshotID]
+	s.mu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "remotedb: unknown snapshot %q", snapshotID)
+	}
+	return snap, nil
+}
@@ -0,0 +1,360 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+//go:build rocksdb
+
+// This file requires cgo and a system librocksdb to build (see
+// https://github.com/tecbot/gorocksdb#installation for setup), so the
+// rocksdb backend and its "rocksdb" registry entry are opt-in: build
+// with -tags rocksdb to enable them. Every other engine, and a plain
+// CGO_ENABLED=0 build, compiles without it.
+package ethdb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/golang/snappy"
+	"github.com/tecbot/gorocksdb"
+)
+
+func init() {
+	Register("rocksdb", func(path string, cache, handles int) (Database, error) {
+		return NewRocksDatabase(path, cache, handles)
+	})
+}
+
+// RocksDatabase is a RocksDB-backed Database, the archive-node-friendly
+// counterpart to BadgerDatabase: RocksDB wins on single-key writes where
+// Badger's LSM + value-log design pays a larger write-amplification cost, at
+// the expense of Badger's faster batched writes. Callers pick whichever
+// fits their workload (archive node, full node, or light server) by passing
+// "rocksdb" or "badger" to Open.
+type RocksDatabase struct {
+	fn string // filename for reporting
+	db *gorocksdb.DB
+
+	ro *gorocksdb.ReadOptions
+	wo *gorocksdb.WriteOptions
+
+	getTimer      metrics.Timer // Timer for measuring the database get request counts and latencies
+	putTimer      metrics.Timer // Timer for measuring the database put request counts and latencies
+	delTimer      metrics.Timer // Timer for measuring the database delete request counts and latencies
+	missMeter     metrics.Meter // Meter for measuring the missed database get requests
+	readMeter     metrics.Meter // Meter for measuring the database get request data usage
+	writeMeter    metrics.Meter // Meter for measuring the database put request data usage
+	batchPutTimer metrics.Timer
+
+	log log.Logger // Contextual logger tracking the database path
+}
+
+// NewRocksDatabase returns a RocksDB wrapped object. cache is the block
+// cache size in MB and handles is the max number of open files; compression
+// is left to the ethdb layer (Put/Get already snappy-encode), so it is
+// disabled here to avoid double-compressing every value.
+func NewRocksDatabase(file string, cache, handles int) (*RocksDatabase, error) {
+	logger := log.New("database", file)
+
+	if cache < 16 {
+		cache = 16
+	}
+	if handles < 16 {
+		handles = 16
+	}
+
+	bbto := gorocksdb.NewDefaultBlockBasedTableOptions()
+	bbto.SetBlockCache(gorocksdb.NewLRUCache(uint64(cache) * 1024 * 1024))
+
+	opts := gorocksdb.NewDefaultOptions()
+	opts.SetBlockBasedTableFactory(bbto)
+	opts.SetCreateIfMissing(true)
+	opts.SetCompression(gorocksdb.NoCompression)
+	opts.SetMaxOpenFiles(handles)
+
+	db, err := gorocksdb.OpenDb(opts, file)
+	if err != nil {
+		return nil, err
+	}
+	return &RocksDatabase{
+		fn:  file,
+		db:  db,
+		ro:  gorocksdb.NewDefaultReadOptions(),
+		wo:  gorocksdb.NewDefaultWriteOptions(),
+		log: logger,
+	}, nil
+}
+
+// Path returns the path to the database directory.
+func (db *RocksDatabase) Path() string {
+	return db.fn
+}
+
+// Put puts the given key / value to the database.
+func (db *RocksDatabase) Put(key []byte, value []byte) error {
+	if db.putTimer != nil {
+		defer db.putTimer.UpdateSince(time.Now())
+	}
+	if db.writeMeter != nil {
+		db.writeMeter.Mark(int64(len(value)))
+	}
+	return db.db.Put(db.wo, key, snappy.Encode(nil, value))
+}
+
+func (db *RocksDatabase) Has(key []byte) (bool, error) {
+	slice, err := db.db.Get(db.ro, key)
+	if err != nil {
+		return false, err
+	}
+	defer slice.Free()
+	return slice.Exists(), nil
+}
+
+// Get returns the given key if it's present.
+func (db *RocksDatabase) Get(key []byte) ([]byte, error) {
+	if db.getTimer != nil {
+		defer db.getTimer.UpdateSince(time.Now())
+	}
+	slice, err := db.db.Get(db.ro, key)
+	if err != nil {
+		return nil, err
+	}
+	defer slice.Free()
+
+	if !slice.Exists() {
+		if db.missMeter != nil {
+			db.missMeter.Mark(1)
+		}
+		return nil, ErrNotFound
+	}
+	dat, err := snappy.Decode(nil, common.CopyBytes(slice.Data()))
+	if err != nil {
+		return nil, err
+	}
+	if db.readMeter != nil {
+		db.readMeter.Mark(int64(len(dat)))
+	}
+	return dat, nil
+}
+
+// Delete deletes the key from the database.
+func (db *RocksDatabase) Delete(key []byte) error {
+	if db.delTimer != nil {
+		defer db.delTimer.UpdateSince(time.Now())
+	}
+	return db.db.Delete(db.wo, key)
+}
+
+func (db *RocksDatabase) Close() {
+	db.db.Close()
+	db.log.Info("Database closed")
+}
+
+// Meter configures the database metrics collectors.
+func (db *RocksDatabase) Meter(prefix string) {
+	if !metrics.Enabled {
+		return
+	}
+	db.getTimer = metrics.NewRegisteredTimer(prefix+"user/gets", nil)
+	db.putTimer = metrics.NewRegisteredTimer(prefix+"user/puts", nil)
+	db.delTimer = metrics.NewRegisteredTimer(prefix+"user/dels", nil)
+	db.missMeter = metrics.NewRegisteredMeter(prefix+"user/misses", nil)
+	db.readMeter = metrics.NewRegisteredMeter(prefix+"user/reads", nil)
+	db.writeMeter = metrics.NewRegisteredMeter(prefix+"user/writes", nil)
+	db.batchPutTimer = metrics.NewRegisteredTimer(prefix+"user/batchPuts", nil)
+}
+
+type rocksIterator struct {
+	internIterator *gorocksdb.Iterator
+	start          []byte
+	limit          []byte
+	prefix         []byte // if set, Next stops once the key no longer shares this prefix
+	released       bool
+	initialised    bool
+}
+
+func (it *rocksIterator) Release() {
+	it.internIterator.Close()
+	it.released = true
+}
+
+func (it *rocksIterator) Released() bool {
+	return it.released
+}
+
+func (it *rocksIterator) Next() bool {
+	if !it.initialised {
+		if it.start != nil {
+			it.internIterator.Seek(it.start)
+		} else {
+			it.internIterator.SeekToFirst()
+		}
+		it.initialised = true
+	} else {
+		it.internIterator.Next()
+	}
+	if !it.internIterator.Valid() {
+		return false
+	}
+	key := it.internIterator.Key().Data()
+	if it.limit != nil && bytes.Compare(key, it.limit) >= 0 {
+		return false
+	}
+	if it.prefix != nil && !bytes.HasPrefix(key, it.prefix) {
+		return false
+	}
+	return true
+}
+
+func (it *rocksIterator) Seek(key []byte) {
+	it.internIterator.Seek(key)
+}
+
+func (it *rocksIterator) Key() []byte {
+	return it.internIterator.Key().Data()
+}
+
+func (it *rocksIterator) Value() []byte {
+	value, err := snappy.Decode(nil, it.internIterator.Value().Data())
+	if err != nil {
+		return nil
+	}
+	return value
+}
+
+func (db *RocksDatabase) NewIterator() Iterator {
+	return &rocksIterator{internIterator: db.db.NewIterator(db.ro)}
+}
+
+func (db *RocksDatabase) NewIteratorWithPrefix(prefix []byte) Iterator {
+	return &rocksIterator{internIterator: db.db.NewIterator(db.ro), start: prefix, prefix: prefix}
+}
+
+func (db *RocksDatabase) NewIteratorWithRange(start, limit []byte) Iterator {
+	return &rocksIterator{internIterator: db.db.NewIterator(db.ro), start: start, limit: limit}
+}
+
+// Snapshot pins a read-only view of the database, mirroring
+// BadgerDatabase.Snapshot.
+func (db *RocksDatabase) Snapshot() (Snapshot, error) {
+	snap := db.db.NewSnapshot()
+	ro := gorocksdb.NewDefaultReadOptions()
+	ro.SetSnapshot(snap)
+	return &rocksSnapshot{db: db, snap: snap, ro: ro}, nil
+}
+
+type rocksSnapshot struct {
+	db   *RocksDatabase
+	snap *gorocksdb.Snapshot
+	ro   *gorocksdb.ReadOptions
+}
+
+func (s *rocksSnapshot) Get(key []byte) ([]byte, error) {
+	slice, err := s.db.db.Get(s.ro, key)
+	if err != nil {
+		return nil, err
+	}
+	defer slice.Free()
+	if !slice.Exists() {
+		return nil, ErrNotFound
+	}
+	return snappy.Decode(nil, common.CopyBytes(slice.Data()))
+}
+
+func (s *rocksSnapshot) Has(key []byte) (bool, error) {
+	slice, err := s.db.db.Get(s.ro, key)
+	if err != nil {
+		return false, err
+	}
+	defer slice.Free()
+	return slice.Exists(), nil
+}
+
+func (s *rocksSnapshot) NewIterator() Iterator {
+	return &rocksIterator{internIterator: s.db.db.NewIterator(s.ro)}
+}
+
+func (s *rocksSnapshot) NewIteratorWithPrefix(prefix []byte) Iterator {
+	return &rocksIterator{internIterator: s.db.db.NewIterator(s.ro), start: prefix, prefix: prefix}
+}
+
+func (s *rocksSnapshot) NewIteratorWithRange(start, limit []byte) Iterator {
+	return &rocksIterator{internIterator: s.db.db.NewIterator(s.ro), start: start, limit: limit}
+}
+
+func (s *rocksSnapshot) Release() {
+	s.db.db.ReleaseSnapshot(s.snap)
+}
+
+type rocksBatch struct {
+	db      *RocksDatabase
+	wb      *gorocksdb.WriteBatch
+	entries []batchEntry
+	size    int
+}
+
+func (db *RocksDatabase) NewBatch() Batch {
+	return &rocksBatch{db: db, wb: gorocksdb.NewWriteBatch()}
+}
+
+func (b *rocksBatch) Put(key, value []byte) error {
+	if b.db.batchPutTimer != nil {
+		defer b.db.batchPutTimer.UpdateSince(time.Now())
+	}
+	key = common.CopyBytes(key)
+	value = common.CopyBytes(value)
+	b.entries = append(b.entries, batchEntry{key: key, value: value})
+	b.size += len(value)
+	b.wb.Put(key, snappy.Encode(nil, value))
+	return nil
+}
+
+func (b *rocksBatch) Write() error {
+	err := b.db.db.Write(b.db.wo, b.wb)
+	b.wb.Destroy()
+	b.wb = gorocksdb.NewWriteBatch()
+	b.entries = nil
+	b.size = 0
+	return err
+}
+
+func (b *rocksBatch) Replay(w func(key, value []byte) error) error {
+	for _, e := range b.entries {
+		if err := w(e.key, e.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *rocksBatch) Discard() {
+	b.wb.Destroy()
+	b.wb = gorocksdb.NewWriteBatch()
+	b.entries = nil
+	b.size = 0
+}
+
+func (b *rocksBatch) ValueSize() int {
+	return b.size
+}
+
+func (b *rocksBatch) Reset() {
+	b.wb.Clear()
+	b.entries = nil
+	b.size = 0
+}